@@ -0,0 +1,43 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"encoding"
+	"errors"
+	"hash"
+)
+
+// Clone returns a new hash.Hash in the same state as src, which must have
+// been returned by h.New(). It works generically across any hash
+// registered with RegisterHash by round-tripping src's state through
+// encoding.BinaryMarshaler/BinaryUnmarshaler, the same interfaces the
+// stdlib hash implementations use to checkpoint state for resumable
+// uploads, Merkle trees, and TLS transcript hashing. Clone returns an
+// error, rather than panicking, for any hash whose New() doesn't produce a
+// BinaryMarshaler/BinaryUnmarshaler — which as of this writing includes
+// SM3, since sm3.New()'s digest type doesn't implement them.
+func (h Hash) Clone(src hash.Hash) (hash.Hash, error) {
+	marshaler, ok := src.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, errors.New("cryptosm: " + h.String() + " does not implement encoding.BinaryMarshaler")
+	}
+	state, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if !h.Available() {
+		return nil, errors.New("cryptosm: requested hash function " + h.String() + " is unavailable")
+	}
+	dst := h.New()
+	unmarshaler, ok := dst.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return nil, errors.New("cryptosm: " + h.String() + " does not implement encoding.BinaryUnmarshaler")
+	}
+	if err := unmarshaler.UnmarshalBinary(state); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHashClone(t *testing.T) {
+	src := SHA256.New()
+	src.Write([]byte("hello "))
+
+	clone, err := SHA256.Clone(src)
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+
+	src.Write([]byte("world"))
+	clone.Write([]byte("world"))
+
+	if !bytes.Equal(src.Sum(nil), clone.Sum(nil)) {
+		t.Fatal("cloned hash diverged from the original after writing the same bytes")
+	}
+}
+
+func TestHashCloneNotMarshalable(t *testing.T) {
+	src := SM3.New()
+	if _, err := SM3.Clone(src); err == nil {
+		t.Fatal("expected an error cloning SM3, whose digest does not yet implement encoding.BinaryMarshaler")
+	}
+}
@@ -20,26 +20,26 @@ import (
 )
 
 func init() {
-	registerHash(MD4, nil)
-	registerHash(MD5, md5.New)
-	registerHash(SHA1, sha1.New)
-	registerHash(SHA224, sha256.New224)
-	registerHash(SHA256, sha256.New)
-	registerHash(SHA384, sha512.New384)
-	registerHash(SHA512, sha512.New)
-	registerHash(MD5SHA1, nil)
-	registerHash(RIPEMD160, ripemd160.New)
-	registerHash(SHA3_224, sha3.New224)
-	registerHash(SHA3_256, sha3.New256)
-	registerHash(SHA3_384, sha3.New384)
-	registerHash(SHA3_512, sha3.New512)
-	registerHash(SHA512_224, sha512.New512_224)
-	registerHash(SHA512_256, sha512.New512_256)
+	RegisterHash(MD4, nil)
+	RegisterHash(MD5, md5.New)
+	RegisterHash(SHA1, sha1.New)
+	RegisterHash(SHA224, sha256.New224)
+	RegisterHash(SHA256, sha256.New)
+	RegisterHash(SHA384, sha512.New384)
+	RegisterHash(SHA512, sha512.New)
+	RegisterHash(MD5SHA1, nil)
+	RegisterHash(RIPEMD160, ripemd160.New)
+	RegisterHash(SHA3_224, sha3.New224)
+	RegisterHash(SHA3_256, sha3.New256)
+	RegisterHash(SHA3_384, sha3.New384)
+	RegisterHash(SHA3_512, sha3.New512)
+	RegisterHash(SHA512_224, sha512.New512_224)
+	RegisterHash(SHA512_256, sha512.New512_256)
 	newHash256 := func() hash.Hash {
 		h, _ := blake2s.New256(nil)
 		return h
 	}
-	registerHash(BLAKE2s_256, newHash256)
+	RegisterHash(BLAKE2s_256, newHash256)
 
 	newHash256 = func() hash.Hash {
 		h, _ := blake2b.New256(nil)
@@ -55,11 +55,11 @@ func init() {
 		return h
 	}
 
-	registerHash(BLAKE2b_256, newHash256)
-	registerHash(BLAKE2b_384, newHash384)
-	registerHash(BLAKE2b_512, newHash512)
+	RegisterHash(BLAKE2b_256, newHash256)
+	RegisterHash(BLAKE2b_384, newHash384)
+	RegisterHash(BLAKE2b_512, newHash512)
 
-	registerHash(SM3, sm3.New)
+	RegisterHash(SM3, sm3.New)
 }
 
 // Hash identifies a cryptographic hash function that is implemented in another
@@ -113,9 +113,11 @@ func (h Hash) String() string {
 		return "BLAKE2b-512"
 	case SM3:
 		return "SM3"
-	default:
-		return "unknown hash value " + strconv.Itoa(int(h))
 	}
+	if name, ok := hashNames[h]; ok {
+		return name
+	}
+	return "unknown hash value " + strconv.Itoa(int(h))
 }
 
 const (
@@ -142,7 +144,11 @@ const (
 	maxHash
 )
 
-var digestSizes = []uint8{
+// digestSizes and hashes are keyed by Hash rather than sized to maxHash so
+// that third-party packages can RegisterHash an ID above maxHash, for
+// algorithms such as SM3-with-ID, HMAC-SM3, Streebog, or other
+// domain-separated variants this module doesn't know about directly.
+var digestSizes = map[Hash]uint8{
 	MD4:         16,
 	MD5:         16,
 	SHA1:        20,
@@ -167,39 +173,54 @@ var digestSizes = []uint8{
 
 // Size returns the length, in bytes, of a digest resulting from the given hash
 // function. It doesn't require that the hash function in question be linked
-// into the program.
+// into the program, but for a hash registered above maxHash with no known
+// size it does require the constructor registered via RegisterHash so the
+// size can be read off of a scratch instance.
 func (h Hash) Size() int {
-	if h > 0 && h < maxHash {
-		return int(digestSizes[h])
+	if size, ok := digestSizes[h]; ok {
+		return int(size)
+	}
+	if f, ok := hashes[h]; ok && f != nil {
+		return f().Size()
 	}
 	panic("crypto: Size of unknown hash function")
 }
 
-var hashes = make([]func() hash.Hash, maxHash)
+var hashes = make(map[Hash]func() hash.Hash)
 
 // New returns a new hash.Hash calculating the given hash function. New panics
 // if the hash function is not linked into the binary.
 func (h Hash) New() hash.Hash {
-	if h > 0 && h < maxHash {
-		f := hashes[h]
-		if f != nil {
-			return f()
-		}
+	if f, ok := hashes[h]; ok && f != nil {
+		return f()
 	}
 	panic("crypto: requested hash function #" + strconv.Itoa(int(h)) + " is unavailable")
 }
 
 // Available reports whether the given hash function is linked into the binary.
 func (h Hash) Available() bool {
-	return h < maxHash && hashes[h] != nil
+	f, ok := hashes[h]
+	return ok && f != nil
 }
 
+var hashNames = make(map[Hash]string)
+
 // RegisterHash registers a function that returns a new instance of the given
 // hash function. This is intended to be called from the init function in
-// packages that implement hash functions.
-func registerHash(h Hash, f func() hash.Hash) {
-	if h >= maxHash {
+// packages that implement hash functions, including third-party packages
+// that allocate their own Hash values above maxHash.
+func RegisterHash(h Hash, f func() hash.Hash) {
+	if h == 0 {
 		panic("cryptosm: RegisterHash of unknown hash function")
 	}
 	hashes[h] = f
 }
+
+// RegisterHashName associates a display name with h, so that Hash.String()
+// (and panic messages that embed it) describe a hash registered above
+// maxHash instead of falling back to "unknown hash value N". Hashes built
+// into this package already have their name wired into Hash.String() and
+// don't need to call this.
+func RegisterHashName(h Hash, name string) {
+	hashNames[h] = name
+}
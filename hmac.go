@@ -0,0 +1,64 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"crypto/hmac"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func init() {
+	registerKeyedHash(BLAKE2s_256, func(key []byte) (hash.Hash, error) { return blake2s.New256(key) })
+	registerKeyedHash(BLAKE2b_256, func(key []byte) (hash.Hash, error) { return blake2b.New256(key) })
+	registerKeyedHash(BLAKE2b_384, func(key []byte) (hash.Hash, error) { return blake2b.New384(key) })
+	registerKeyedHash(BLAKE2b_512, func(key []byte) (hash.Hash, error) { return blake2b.New512(key) })
+}
+
+var keyedHashes = make(map[Hash]func(key []byte) (hash.Hash, error))
+
+// registerKeyedHash registers h's native keyed-hash constructor, so HMAC
+// can dispatch to it instead of wrapping h in HMAC. It is unexported, so
+// only this package's init registers keyed modes; today those are the
+// BLAKE2b/BLAKE2s ones.
+func registerKeyedHash(h Hash, f func(key []byte) (hash.Hash, error)) {
+	keyedHashes[h] = f
+}
+
+// HMAC returns a hash.Hash computing the keyed hash of h over key. Hashes
+// registered via registerKeyedHash dispatch to their native keyed mode
+// instead of being wrapped in HMAC, per each algorithm's own recommendation
+// for use as a MAC; everything else, including SM3, gets plain HMAC(h, key).
+func (h Hash) HMAC(key []byte) hash.Hash {
+	if f, ok := keyedHashes[h]; ok {
+		mac, err := f(key)
+		if err != nil {
+			panic("cryptosm: " + h.String() + " rejected HMAC key: " + err.Error())
+		}
+		return mac
+	}
+	return hmac.New(h.New, key)
+}
+
+// HKDF derives length bytes of key material from secret and salt using
+// HKDF (RFC 5869) with h as the underlying hash.
+func (h Hash) HKDF(secret, salt, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(h.New, secret, salt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PBKDF2 derives a keyLen-byte key from password and salt using PBKDF2
+// (RFC 8018) with iter iterations of HMAC-h.
+func (h Hash) PBKDF2(password, salt []byte, iter, keyLen int) []byte {
+	return pbkdf2.Key(password, salt, iter, keyLen, h.New)
+}
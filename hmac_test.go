@@ -0,0 +1,39 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"testing"
+)
+
+func TestHashHMACDispatch(t *testing.T) {
+	key := []byte("key")
+
+	t.Run("BLAKE2 uses its native keyed mode", func(t *testing.T) {
+		mac := BLAKE2b_256.HMAC(key)
+		wrapped := hmac.New(BLAKE2b_256.New, key)
+
+		mac.Write([]byte("message"))
+		wrapped.Write([]byte("message"))
+
+		if bytes.Equal(mac.Sum(nil), wrapped.Sum(nil)) {
+			t.Fatal("BLAKE2b_256.HMAC matched a plain HMAC wrapper; expected it to dispatch to the native keyed mode instead")
+		}
+	})
+
+	t.Run("SM3 falls through to hmac.New", func(t *testing.T) {
+		mac := SM3.HMAC(key)
+		wrapped := hmac.New(SM3.New, key)
+
+		mac.Write([]byte("message"))
+		wrapped.Write([]byte("message"))
+
+		if !bytes.Equal(mac.Sum(nil), wrapped.Sum(nil)) {
+			t.Fatal("SM3.HMAC did not match hmac.New(SM3.New, key); expected it to fall through since SM3 has no native keyed mode registered")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import "encoding/asn1"
+
+// Object identifiers for the digest algorithms cryptosm knows about. SM3
+// and the SM2-with-SM3 signature algorithm come from GM/T 0006-2012 and
+// GM/T 0009-2012; the SHA-2/SHA-3 arcs are the usual ones X.509 and PKCS
+// tooling already expect; the BLAKE2 arcs are the ones RFC 7693 assigns
+// under 1.3.6.1.4.1.1722.12.2, whose last arc is the digest size in 32-bit words.
+var (
+	oidSM3         = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 401}
+	oidMD5         = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 5}
+	oidSHA1        = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA224      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 4}
+	oidSHA256      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+	oidSHA512_224  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 5}
+	oidSHA512_256  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 6}
+	oidSHA3_224    = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 7}
+	oidSHA3_256    = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 8}
+	oidSHA3_384    = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 9}
+	oidSHA3_512    = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 10}
+	oidBLAKE2s_256 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 1722, 12, 2, 2, 8}
+	oidBLAKE2b_256 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 1722, 12, 2, 1, 8}
+	oidBLAKE2b_384 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 1722, 12, 2, 1, 12}
+	oidBLAKE2b_512 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 1722, 12, 2, 1, 16}
+)
+
+// OIDSM2WithSM3 is the signature algorithm identifier for SM2 signing over
+// an SM3 digest (GM/T 0009-2012), for packages such as x509sm that marshal
+// SignatureAlgorithm fields rather than digest algorithm fields.
+var OIDSM2WithSM3 = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 501}
+
+var hashOIDs = make(map[Hash]asn1.ObjectIdentifier)
+var oidToHash = make(map[string]Hash)
+
+func init() {
+	RegisterHashOID(MD5, oidMD5)
+	RegisterHashOID(SHA1, oidSHA1)
+	RegisterHashOID(SHA224, oidSHA224)
+	RegisterHashOID(SHA256, oidSHA256)
+	RegisterHashOID(SHA384, oidSHA384)
+	RegisterHashOID(SHA512, oidSHA512)
+	RegisterHashOID(SHA512_224, oidSHA512_224)
+	RegisterHashOID(SHA512_256, oidSHA512_256)
+	RegisterHashOID(SHA3_224, oidSHA3_224)
+	RegisterHashOID(SHA3_256, oidSHA3_256)
+	RegisterHashOID(SHA3_384, oidSHA3_384)
+	RegisterHashOID(SHA3_512, oidSHA3_512)
+	RegisterHashOID(BLAKE2s_256, oidBLAKE2s_256)
+	RegisterHashOID(BLAKE2b_256, oidBLAKE2b_256)
+	RegisterHashOID(BLAKE2b_384, oidBLAKE2b_384)
+	RegisterHashOID(BLAKE2b_512, oidBLAKE2b_512)
+	RegisterHashOID(SM3, oidSM3)
+}
+
+// RegisterHashOID associates an ASN.1 object identifier with h. It is
+// intended to be called from the init function of packages that register
+// a Hash via RegisterHash, the same way RegisterHashName associates a
+// display name.
+func RegisterHashOID(h Hash, oid asn1.ObjectIdentifier) {
+	hashOIDs[h] = oid
+	oidToHash[oid.String()] = h
+}
+
+// OID returns the ASN.1 object identifier registered for h, or nil if none
+// has been registered.
+func (h Hash) OID() asn1.ObjectIdentifier {
+	return hashOIDs[h]
+}
+
+// HashFromOID returns the Hash registered for oid via RegisterHashOID, and
+// false if no Hash has been associated with it.
+func HashFromOID(oid asn1.ObjectIdentifier) (Hash, bool) {
+	h, ok := oidToHash[oid.String()]
+	return h, ok
+}
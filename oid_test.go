@@ -0,0 +1,35 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import "testing"
+
+func TestHashOIDRoundTrip(t *testing.T) {
+	for _, h := range []Hash{
+		MD5, SHA1, SHA224, SHA256, SHA384, SHA512,
+		SHA512_224, SHA512_256, SHA3_224, SHA3_256, SHA3_384, SHA3_512,
+		BLAKE2s_256, BLAKE2b_256, BLAKE2b_384, BLAKE2b_512, SM3,
+	} {
+		oid := h.OID()
+		if oid == nil {
+			t.Errorf("%s: OID returned nil", h)
+			continue
+		}
+		got, ok := HashFromOID(oid)
+		if !ok {
+			t.Errorf("%s: HashFromOID(%v) not found", h, oid)
+			continue
+		}
+		if got != h {
+			t.Errorf("%s: HashFromOID(%v) = %s, want %s", h, oid, got, h)
+		}
+	}
+}
+
+func TestHashOIDUnregistered(t *testing.T) {
+	if oid := RIPEMD160.OID(); oid != nil {
+		t.Errorf("RIPEMD160.OID() = %v, want nil", oid)
+	}
+}
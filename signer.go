@@ -0,0 +1,83 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import "io"
+
+// PublicKey represents a public key using an unspecified algorithm.
+type PublicKey interface{}
+
+// PrivateKey represents a private key using an unspecified algorithm.
+//
+// A package that wants to satisfy Signer and/or Decrypter against a
+// concrete key type — an sm2.PrivateKey, for instance, returning
+// ASN.1-encoded SM2 signatures and ciphertexts — implements this interface
+// the way stdlib's rsa.PrivateKey and ecdsa.PrivateKey satisfy
+// crypto.Signer. No such wiring exists in this module yet; these are the
+// interfaces a future sm2 package would implement against.
+type PrivateKey interface{}
+
+// Signer is an interface for an opaque private key that can be used for
+// signing operations. For example, an SM2 key held in a hardware module.
+type Signer interface {
+	// Public returns the public key corresponding to the opaque,
+	// private key.
+	Public() PublicKey
+
+	// Sign signs digest with the private key, possibly using entropy
+	// from rand. A Signer backed by SM2 is expected to take opts as an
+	// SM2SignerOpts and treat digest as the SM3 hash of the message
+	// mixed with the user identity via the SM2 Z value (GB/T 32918.2-2016
+	// §5.5), with opts.HashFunc() identifying the hash used to produce
+	// it — but that contract has no implementation in this module yet.
+	Sign(rand io.Reader, digest []byte, opts SignerOpts) (signature []byte, err error)
+}
+
+// SignerOpts contains options for signing with a Signer.
+type SignerOpts interface {
+	// HashFunc returns an identifier for the hash function used to produce
+	// the message passed to Signer.Sign, or zero if no hashing was
+	// performed.
+	HashFunc() Hash
+}
+
+// DefaultSM2UID is the user identifier GB/T 32918.2-2016 recommends when
+// the communicating parties have not negotiated one of their own.
+var DefaultSM2UID = []byte("1234567812345678")
+
+// SM2SignerOpts carries the user identifier SM2 mixes into the Z value
+// ahead of hashing, alongside the Hash to use. Passing a zero Hash to
+// Sign is treated as SM3, SM2's natural pairing.
+type SM2SignerOpts struct {
+	Hash Hash
+	UID  []byte
+}
+
+// HashFunc returns o.Hash, defaulting to SM3 so SM2 signing has a sane
+// default when callers build an SM2SignerOpts without setting Hash.
+func (o SM2SignerOpts) HashFunc() Hash {
+	if o.Hash == 0 {
+		return SM3
+	}
+	return o.Hash
+}
+
+// DecrypterOpts contains options for decrypting with a Decrypter.
+type DecrypterOpts interface{}
+
+// Decrypter is an interface for an opaque private key that can be used for
+// asymmetric decryption operations. For example, an SM2 key held in a
+// hardware module — though as with Signer, no type in this module
+// implements it yet.
+type Decrypter interface {
+	// Public returns the public key corresponding to the opaque,
+	// private key.
+	Public() PublicKey
+
+	// Decrypt decrypts msg. The opts argument should be appropriate for
+	// the primitive used; a future SM2 Decrypter is expected to accept
+	// nil to select its default ciphertext encoding.
+	Decrypt(rand io.Reader, msg []byte, opts DecrypterOpts) (plaintext []byte, err error)
+}
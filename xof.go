@@ -0,0 +1,74 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"strconv"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func init() {
+	RegisterXOF(SHAKE128, sha3.NewShake128)
+	RegisterXOF(SHAKE256, sha3.NewShake256)
+}
+
+// XOF identifies an extendable-output function: a hash construction whose
+// output length isn't fixed the way hash.Hash.Size() assumes, so it is
+// registered and looked up through its own table rather than Hash's.
+type XOF uint
+
+const (
+	SHAKE128 XOF = 1 + iota // import golang.org/x/crypto/sha3
+	SHAKE256                // import golang.org/x/crypto/sha3
+	maxXOF
+)
+
+func (x XOF) String() string {
+	switch x {
+	case SHAKE128:
+		return "SHAKE128"
+	case SHAKE256:
+		return "SHAKE256"
+	}
+	if name, ok := xofNames[x]; ok {
+		return name
+	}
+	return "unknown XOF value " + strconv.Itoa(int(x))
+}
+
+var xofs = make(map[XOF]func() sha3.ShakeHash)
+var xofNames = make(map[XOF]string)
+
+// RegisterXOF registers a constructor for the given XOF. It is intended to
+// be called from the init function of packages that implement XOFs, such
+// as a future SM3-based XOF, the same way RegisterHash works for Hash.
+func RegisterXOF(x XOF, f func() sha3.ShakeHash) {
+	if x == 0 {
+		panic("cryptosm: RegisterXOF of unknown XOF")
+	}
+	xofs[x] = f
+}
+
+// RegisterXOFName associates a display name with x, for XOFs registered
+// above maxXOF whose String() would otherwise read "unknown XOF value N".
+func RegisterXOFName(x XOF, name string) {
+	xofNames[x] = name
+}
+
+// New returns a new sha3.ShakeHash computing x. New panics if x is not
+// linked into the binary.
+func (x XOF) New() sha3.ShakeHash {
+	if f, ok := xofs[x]; ok && f != nil {
+		return f()
+	}
+	panic("cryptosm: requested XOF #" + strconv.Itoa(int(x)) + " is unavailable")
+}
+
+// Available reports whether the given XOF is linked into the binary.
+func (x XOF) Available() bool {
+	f, ok := xofs[x]
+	return ok && f != nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cryptosm
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestXOFRegistry(t *testing.T) {
+	for _, x := range []XOF{SHAKE128, SHAKE256} {
+		if !x.Available() {
+			t.Errorf("%s: not available", x)
+			continue
+		}
+		out := make([]byte, 32)
+		if _, err := x.New().Read(out); err != nil {
+			t.Errorf("%s: Read: %v", x, err)
+		}
+	}
+}
+
+func TestRegisterXOF(t *testing.T) {
+	const thirdParty XOF = maxXOF + 1
+	RegisterXOFName(thirdParty, "THIRD-PARTY-XOF")
+	if thirdParty.Available() {
+		t.Fatal("expected thirdParty to be unavailable before RegisterXOF")
+	}
+
+	RegisterXOF(thirdParty, sha3.NewShake128)
+	if !thirdParty.Available() {
+		t.Fatal("expected thirdParty to be available after RegisterXOF")
+	}
+	if got, want := thirdParty.String(), "THIRD-PARTY-XOF"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	a := make([]byte, 16)
+	b := make([]byte, 16)
+	thirdParty.New().Read(a)
+	SHAKE128.New().Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("thirdParty registered with SHAKE128's constructor produced different output")
+	}
+}